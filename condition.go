@@ -21,18 +21,38 @@ func buildCond(d Dialect, buf Buffer, pred string, cond ...Builder) error {
 	return nil
 }
 
+type condList struct {
+	pred string
+	cond []Builder
+}
+
+func (c *condList) Build(d Dialect, buf Buffer) error {
+	return buildCond(d, buf, c.pred, c.cond...)
+}
+
+// NegationBuild pushes the negation down via De Morgan's law: every
+// child is wrapped in Not and the list's predicate is flipped, so
+// Not(And(a, b)) becomes `(NOT a) OR (NOT b)` instead of `NOT (a AND b)`.
+func (c *condList) NegationBuild(d Dialect, buf Buffer) error {
+	negPred := "OR"
+	if c.pred == "OR" {
+		negPred = "AND"
+	}
+	negated := make([]Builder, len(c.cond))
+	for i, cd := range c.cond {
+		negated[i] = Not(cd)
+	}
+	return buildCond(d, buf, negPred, negated...)
+}
+
 // And creates AND from a list of conditions
 func And(cond ...Builder) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCond(d, buf, "AND", cond...)
-	})
+	return &condList{pred: "AND", cond: cond}
 }
 
 // Or creates OR from a list of conditions
 func Or(cond ...Builder) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCond(d, buf, "OR", cond...)
-	})
+	return &condList{pred: "OR", cond: cond}
 }
 
 func buildCmp(d Dialect, buf Buffer, pred string, column string, value interface{}) error {
@@ -46,27 +66,54 @@ func buildCmp(d Dialect, buf Buffer, pred string, column string, value interface
 	return nil
 }
 
+type eqCond struct {
+	column string
+	value  interface{}
+	neg    bool
+}
+
+func (c *eqCond) build(d Dialect, buf Buffer, neg bool) error {
+	if c.value == nil {
+		buf.WriteString(d.QuoteIdent(c.column))
+		if neg {
+			buf.WriteString(" IS NOT NULL")
+		} else {
+			buf.WriteString(" IS NULL")
+		}
+		return nil
+	}
+
+	v := reflect.ValueOf(c.value)
+	if v.Kind() == reflect.Slice {
+		if v.Len() == 0 {
+			buf.WriteString(d.EncodeBool(neg))
+			return nil
+		}
+		if neg {
+			return buildCmp(d, buf, "NOT IN", c.column, c.value)
+		}
+		return buildCmp(d, buf, "IN", c.column, c.value)
+	}
+
+	if neg {
+		return buildCmp(d, buf, "!=", c.column, c.value)
+	}
+	return buildCmp(d, buf, "=", c.column, c.value)
+}
+
+func (c *eqCond) Build(d Dialect, buf Buffer) error { return c.build(d, buf, c.neg) }
+
+// NegationBuild builds the opposite comparison in place, e.g.
+// Not(Eq("x", nil)) becomes `x IS NOT NULL` and Not(Eq("x", vals)) with
+// a slice becomes `x NOT IN (...)`.
+func (c *eqCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
 // Eq is `=`.
 // When value is nil, it will be translated to `IS NULL`.
 // When value is a slice, it will be translated to `IN`.
 // Otherwise it will be translated to `=`.
 func Eq(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		if value == nil {
-			buf.WriteString(d.QuoteIdent(column))
-			buf.WriteString(" IS NULL")
-			return nil
-		}
-		v := reflect.ValueOf(value)
-		if v.Kind() == reflect.Slice {
-			if v.Len() == 0 {
-				buf.WriteString(d.EncodeBool(false))
-				return nil
-			}
-			return buildCmp(d, buf, "IN", column, value)
-		}
-		return buildCmp(d, buf, "=", column, value)
-	})
+	return &eqCond{column: column, value: value}
 }
 
 // Neq is `!=`.
@@ -74,88 +121,110 @@ func Eq(column string, value interface{}) Builder {
 // When value is a slice, it will be translated to `NOT IN`.
 // Otherwise it will be translated to `!=`.
 func Neq(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		if value == nil {
-			buf.WriteString(d.QuoteIdent(column))
-			buf.WriteString(" IS NOT NULL")
-			return nil
-		}
-		v := reflect.ValueOf(value)
-		if v.Kind() == reflect.Slice {
-			if v.Len() == 0 {
-				buf.WriteString(d.EncodeBool(true))
-				return nil
-			}
-			return buildCmp(d, buf, "NOT IN", column, value)
-		}
-		return buildCmp(d, buf, "!=", column, value)
-	})
+	return &eqCond{column: column, value: value, neg: true}
+}
+
+type cmpCond struct {
+	pred    string
+	negPred string
+	column  string
+	value   interface{}
+}
+
+func (c *cmpCond) Build(d Dialect, buf Buffer) error {
+	return buildCmp(d, buf, c.pred, c.column, c.value)
+}
+
+// NegationBuild flips the comparison operator, e.g. Not(Gt(...)) becomes `<=`.
+func (c *cmpCond) NegationBuild(d Dialect, buf Buffer) error {
+	return buildCmp(d, buf, c.negPred, c.column, c.value)
 }
 
 // Gt is `>`.
 func Gt(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCmp(d, buf, ">", column, value)
-	})
+	return &cmpCond{pred: ">", negPred: "<=", column: column, value: value}
 }
 
 // Gte is '>='.
 func Gte(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCmp(d, buf, ">=", column, value)
-	})
+	return &cmpCond{pred: ">=", negPred: "<", column: column, value: value}
 }
 
 // Lt is '<'.
 func Lt(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCmp(d, buf, "<", column, value)
-	})
+	return &cmpCond{pred: "<", negPred: ">=", column: column, value: value}
 }
 
 // Lte is `<=`.
 func Lte(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildCmp(d, buf, "<=", column, value)
-	})
+	return &cmpCond{pred: "<=", negPred: ">", column: column, value: value}
 }
 
 func buildLikeCmp(d Dialect, buf Buffer, pred string, column string, value interface{}) error {
+	v, err := normalizeLikeValue(value)
+	if err != nil {
+		return err
+	}
+	return buildCmp(d, buf, pred, column, v)
+}
+
+// normalizeLikeValue validates a LIKE-family value and resolves it down
+// to something buildCmp can bind directly: strings and byte slices pass
+// through, runes are converted to a string, pointers and interfaces are
+// dereferenced, and anything else is rejected. Shared by buildLikeCmp
+// and buildILikeCmp so Like/NotLike and ILike/NotILike accept the same
+// inputs.
+func normalizeLikeValue(value interface{}) (interface{}, error) {
 	if value == nil {
-		return ErrColumnNotSpecified
+		return nil, ErrColumnNotSpecified
 	}
 
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
 	case reflect.String:
 		// pass as is
-		return buildCmp(d, buf, pred, column, value)
+		return value, nil
 	case reflect.Ptr, reflect.Interface: // pointer or interface
 		// for pointers & interfaces check
-		return buildLikeCmp(d, buf, pred, column, v.Elem().Interface())
+		return normalizeLikeValue(v.Elem().Interface())
 	case reflect.Slice:
 		switch v.Type().Elem().Kind() {
 		case reflect.Uint8: // bytes
 			// interpolator will handle this case
-			return buildCmp(d, buf, pred, column, value)
+			return value, nil
 		case reflect.Int32: // rune
 			// need to convert into string
-			return buildCmp(d, buf, pred, column, string(value.([]rune)))
+			return string(value.([]rune)), nil
 		}
 		fallthrough
 	default:
-		return ErrColumnNotSpecified
+		return nil, ErrColumnNotSpecified
 	}
 }
 
+type likeCond struct {
+	column string
+	value  interface{}
+	neg    bool
+}
+
+func (c *likeCond) build(d Dialect, buf Buffer, neg bool) error {
+	pred := "LIKE"
+	if neg {
+		pred = "NOT LIKE"
+	}
+	return buildLikeCmp(d, buf, pred, c.column, c.value)
+}
+
+func (c *likeCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *likeCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
 // Like is `LIKE`.
 // When value is nil, do nothing.
 // When value is a slice, do nothing.
 // Otherwise it will be translated to `LIKE`.
 func Like(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildLikeCmp(d, buf, "LIKE", column, value)
-	})
+	return &likeCond{column: column, value: value}
 }
 
 // NotLike is `NOT LIKE`.
@@ -163,7 +232,5 @@ func Like(column string, value interface{}) Builder {
 // When value is a slice, do nothing.
 // Otherwise it will be translated to `NOT LIKE`.
 func NotLike(column string, value interface{}) Builder {
-	return BuildFunc(func(d Dialect, buf Buffer) error {
-		return buildLikeCmp(d, buf, "NOT LIKE", column, value)
-	})
+	return &likeCond{column: column, value: value, neg: true}
 }
@@ -0,0 +1,152 @@
+package dbr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+)
+
+// ErrArgumentMismatch is returned when a placeholder has no var to fill
+// it: by Expr when the raw SQL has more `?` than there are vars, and by
+// NamedExpr when a marker's name is not a key of vars.
+var ErrArgumentMismatch = errors.New("dbr: not enough arguments for placeholders")
+
+// Expr builds a raw SQL fragment. It can be used anywhere a Builder is
+// accepted, so hand-written SQL can be mixed with the typed conditions
+// in this package, e.g. Expr("id IN (?) AND status = ?", ids, "active").
+//
+// Each `?` in sql consumes the next value from vars. When that value is
+// a slice or array (other than []byte) and the `?` sits immediately
+// after `(`, it is fanned out into one placeholder per element so
+// `(?)` becomes `(?,?,?)`; a bare `?` not preceded by `(` keeps the
+// slice as a single value instead. Values implementing driver.Valuer
+// are always written as a single placeholder.
+func Expr(sql string, vars ...interface{}) Builder {
+	return &rawExpr{raw: sql, vars: vars}
+}
+
+type rawExpr struct {
+	raw  string
+	vars []interface{}
+}
+
+func (e *rawExpr) Build(d Dialect, buf Buffer) error {
+	return writePositionalExpr(d, buf, e.raw, e.vars)
+}
+
+func writePositionalExpr(d Dialect, buf Buffer, raw string, vars []interface{}) error {
+	start := 0
+	pos := 0
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '?' {
+			continue
+		}
+		if pos >= len(vars) {
+			return ErrArgumentMismatch
+		}
+		buf.WriteString(raw[start:i])
+		start = i + 1
+
+		v := vars[pos]
+		pos++
+		if err := writePlaceholder(d, buf, v, i > 0 && raw[i-1] == '('); err != nil {
+			return err
+		}
+	}
+	buf.WriteString(raw[start:])
+	return nil
+}
+
+// writePlaceholder writes a single `?` worth of v. When fanOut is true
+// and v is a slice or array of something other than bytes, it is
+// expanded into a comma-separated list of placeholders instead.
+func writePlaceholder(d Dialect, buf Buffer, v interface{}, fanOut bool) error {
+	if _, ok := v.(driver.Valuer); ok {
+		buf.WriteString(placeholder)
+		buf.WriteValue(v)
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if fanOut && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+		n := rv.Len()
+		if n == 0 {
+			buf.WriteString(d.EncodeBool(false))
+			return nil
+		}
+		for j := 0; j < n; j++ {
+			if j > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(placeholder)
+			buf.WriteValue(rv.Index(j).Interface())
+		}
+		return nil
+	}
+
+	buf.WriteString(placeholder)
+	buf.WriteValue(v)
+	return nil
+}
+
+// NamedExpr is like Expr but substitutes `@name` or `:name` markers
+// found in sql with the matching entry in vars instead of positional
+// `?` placeholders. Slice fan-out after `(` works the same as Expr.
+// A `::` is treated as a Postgres type cast rather than a marker, so
+// `created_at::date` passes through untouched; a dialect that uses `:`
+// for anything else immediately followed by another `:` will see the
+// same treatment.
+func NamedExpr(sql string, vars map[string]interface{}) Builder {
+	return &namedExpr{raw: sql, vars: vars}
+}
+
+type namedExpr struct {
+	raw  string
+	vars map[string]interface{}
+}
+
+func (e *namedExpr) Build(d Dialect, buf Buffer) error {
+	raw := e.raw
+	start := 0
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '@' && raw[i] != ':' {
+			continue
+		}
+		// `::` is a Postgres cast operator (e.g. `created_at::date`),
+		// not a named marker; skip both colons so the type name after
+		// it is never mistaken for one either.
+		if raw[i] == ':' && i+1 < len(raw) && raw[i+1] == ':' {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(raw) && isNameByte(raw[j]) {
+			j++
+		}
+		if j == i+1 {
+			continue
+		}
+
+		name := raw[i+1 : j]
+		v, ok := e.vars[name]
+		if !ok {
+			return ErrArgumentMismatch
+		}
+
+		buf.WriteString(raw[start:i])
+		start = j
+		if err := writePlaceholder(d, buf, v, i > 0 && raw[i-1] == '('); err != nil {
+			return err
+		}
+		i = j - 1
+	}
+	buf.WriteString(raw[start:])
+	return nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
@@ -0,0 +1,102 @@
+package dbr
+
+import "testing"
+
+func TestExprPositionalPassthrough(t *testing.T) {
+	buf := newStubBuffer()
+	err := Expr("status = ?", "active").Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "status = ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+	if got, want := buf.Value(), []interface{}{"active"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+}
+
+func TestExprSliceFanOutInsideParens(t *testing.T) {
+	buf := newStubBuffer()
+	err := Expr("id IN (?)", []int{1, 2, 3}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "id IN (?,?,?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+	if len(buf.Value()) != 3 {
+		t.Errorf("values = %v, want 3 elements", buf.Value())
+	}
+}
+
+func TestExprBareSliceStaysSingleValue(t *testing.T) {
+	buf := newStubBuffer()
+	ids := []int{1, 2, 3}
+	err := Expr("tags = ?", ids).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "tags = ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+	if len(buf.Value()) != 1 {
+		t.Fatalf("values = %v, want 1 element", buf.Value())
+	}
+	got, ok := buf.Value()[0].([]int)
+	if !ok || len(got) != 3 {
+		t.Errorf("value = %v, want the original slice untouched", buf.Value()[0])
+	}
+}
+
+func TestExprEmptySliceFanOut(t *testing.T) {
+	buf := newStubBuffer()
+	err := Expr("id IN (?)", []int{}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "id IN (0)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestExprArgumentMismatch(t *testing.T) {
+	buf := newStubBuffer()
+	err := Expr("a = ? AND b = ?", 1).Build(stubDialect{}, buf)
+	if err != ErrArgumentMismatch {
+		t.Errorf("err = %v, want ErrArgumentMismatch", err)
+	}
+}
+
+func TestNamedExprSubstitution(t *testing.T) {
+	buf := newStubBuffer()
+	err := NamedExpr("status = @status AND id IN (@ids)", map[string]interface{}{
+		"status": "active",
+		"ids":    []int{1, 2},
+	}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "status = ? AND id IN (?,?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNamedExprMissingVar(t *testing.T) {
+	buf := newStubBuffer()
+	err := NamedExpr("status = @status", nil).Build(stubDialect{}, buf)
+	if err != ErrArgumentMismatch {
+		t.Errorf("err = %v, want ErrArgumentMismatch", err)
+	}
+}
+
+func TestNamedExprSkipsPostgresCast(t *testing.T) {
+	buf := newStubBuffer()
+	err := NamedExpr("created_at::date = @d", map[string]interface{}{"d": "2020-01-01"}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "created_at::date = ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
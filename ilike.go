@@ -0,0 +1,136 @@
+package dbr
+
+import (
+	"strings"
+)
+
+// CaseInsensitiveLikeDialect is implemented by dialects that can emit a
+// case-insensitive LIKE comparison directly, such as PostgreSQL's
+// ILIKE. operator is "ILIKE" or "NOT ILIKE" so the dialect can place
+// NOT next to the operator the way every other negated predicate in
+// this package does. Dialects that don't implement it get a portable
+// fallback of LOWER(column) LIKE LOWER(?) / LOWER(column) NOT LIKE
+// LOWER(?) instead.
+type CaseInsensitiveLikeDialect interface {
+	CaseInsensitiveLike(column, operator, placeholder string) string
+}
+
+func buildILikeCmp(d Dialect, buf Buffer, neg bool, column string, value interface{}) error {
+	v, err := normalizeLikeValue(value)
+	if err != nil {
+		return err
+	}
+
+	col := d.QuoteIdent(column)
+	op := "ILIKE"
+	if neg {
+		op = "NOT ILIKE"
+	}
+
+	if cd, ok := d.(CaseInsensitiveLikeDialect); ok {
+		buf.WriteString(cd.CaseInsensitiveLike(col, op, placeholder))
+		buf.WriteValue(v)
+		return nil
+	}
+
+	likePred := "LIKE"
+	if neg {
+		likePred = "NOT LIKE"
+	}
+	buf.WriteString("LOWER(")
+	buf.WriteString(col)
+	buf.WriteString(") ")
+	buf.WriteString(likePred)
+	buf.WriteString(" LOWER(")
+	buf.WriteString(placeholder)
+	buf.WriteString(")")
+	buf.WriteValue(v)
+	return nil
+}
+
+type iLikeCond struct {
+	column string
+	value  interface{}
+	neg    bool
+}
+
+func (c *iLikeCond) build(d Dialect, buf Buffer, neg bool) error {
+	return buildILikeCmp(d, buf, neg, c.column, c.value)
+}
+
+func (c *iLikeCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *iLikeCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
+// ILike is a case-insensitive LIKE. On a dialect implementing
+// CaseInsensitiveLikeDialect (e.g. PostgreSQL) it emits ILIKE; otherwise
+// it falls back to LOWER(column) LIKE LOWER(?).
+func ILike(column string, value interface{}) Builder {
+	return &iLikeCond{column: column, value: value}
+}
+
+// NotILike is the negated form of ILike.
+func NotILike(column string, value interface{}) Builder {
+	return &iLikeCond{column: column, value: value, neg: true}
+}
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLikePattern escapes %, _ and \ in s so it can be embedded in a
+// LIKE pattern and matched literally.
+func escapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+type patternLikeCond struct {
+	column  string
+	pattern string
+}
+
+// LikeEscapeClauseDialect is implemented by dialects whose string
+// literal rules need something other than the ANSI-standard
+// `ESCAPE '\'` clause — MySQL's default sql_mode treats backslash as
+// an escape character inside string literals, so there it must be
+// doubled to `ESCAPE '\\'` or the literal never terminates.
+type LikeEscapeClauseDialect interface {
+	LikeEscapeClause() string
+}
+
+const defaultLikeEscapeClause = ` ESCAPE '\'`
+
+func likeEscapeClause(d Dialect) string {
+	if ed, ok := d.(LikeEscapeClauseDialect); ok {
+		return ed.LikeEscapeClause()
+	}
+	return defaultLikeEscapeClause
+}
+
+func (c *patternLikeCond) build(d Dialect, buf Buffer, neg bool) error {
+	buf.WriteString(d.QuoteIdent(c.column))
+	if neg {
+		buf.WriteString(" NOT LIKE ")
+	} else {
+		buf.WriteString(" LIKE ")
+	}
+	buf.WriteString(placeholder)
+	buf.WriteValue(c.pattern)
+	buf.WriteString(likeEscapeClause(d))
+	return nil
+}
+
+func (c *patternLikeCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, false) }
+func (c *patternLikeCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, true) }
+
+// Contains is `LIKE '%s%'` with s's %, _ and \ escaped so the match is literal.
+func Contains(column, s string) Builder {
+	return &patternLikeCond{column: column, pattern: "%" + escapeLikePattern(s) + "%"}
+}
+
+// HasPrefix is `LIKE 's%'` with s's %, _ and \ escaped so the match is literal.
+func HasPrefix(column, s string) Builder {
+	return &patternLikeCond{column: column, pattern: escapeLikePattern(s) + "%"}
+}
+
+// HasSuffix is `LIKE '%s'` with s's %, _ and \ escaped so the match is literal.
+func HasSuffix(column, s string) Builder {
+	return &patternLikeCond{column: column, pattern: "%" + escapeLikePattern(s)}
+}
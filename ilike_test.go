@@ -0,0 +1,110 @@
+package dbr
+
+import "testing"
+
+func TestILikeFallsBackToLower(t *testing.T) {
+	buf := newStubBuffer()
+	err := ILike("name", "bob").Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "LOWER(`name`) LIKE LOWER(?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotILikeFallsBackToLower(t *testing.T) {
+	buf := newStubBuffer()
+	err := NotILike("name", "bob").Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "LOWER(`name`) NOT LIKE LOWER(?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestILikeUsesDialectHook(t *testing.T) {
+	buf := newStubBuffer()
+	err := ILike("name", "bob").Build(stubPostgres{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`name` ILIKE ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotILike(t *testing.T) {
+	buf := newStubBuffer()
+	err := NotILike("name", "bob").Build(stubPostgres{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`name` NOT ILIKE ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestILikeRejectsInvalidValue(t *testing.T) {
+	buf := newStubBuffer()
+	err := ILike("age", 42).Build(stubDialect{}, buf)
+	if err != ErrColumnNotSpecified {
+		t.Errorf("err = %v, want ErrColumnNotSpecified", err)
+	}
+}
+
+func TestILikeDereferencesPointer(t *testing.T) {
+	s := "bob"
+	buf := newStubBuffer()
+	err := ILike("name", &s).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.Value(), []interface{}{"bob"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+}
+
+func TestContainsEscapesPattern(t *testing.T) {
+	buf := newStubBuffer()
+	err := Contains("name", "50%_off\\").Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), `` + "`name` LIKE ?" + defaultLikeEscapeClause; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+	if got, want := buf.Value(), []interface{}{`%50\%\_off\\%`}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+}
+
+func TestHasPrefixAndHasSuffix(t *testing.T) {
+	buf := newStubBuffer()
+	if err := HasPrefix("name", "bob").Build(stubDialect{}, buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.Value()[0], "bob%"; got != want {
+		t.Errorf("value = %v, want %v", got, want)
+	}
+
+	buf = newStubBuffer()
+	if err := HasSuffix("name", "bob").Build(stubDialect{}, buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.Value()[0], "%bob"; got != want {
+		t.Errorf("value = %v, want %v", got, want)
+	}
+}
+
+func TestContainsUsesDialectEscapeClause(t *testing.T) {
+	buf := newStubBuffer()
+	err := Contains("name", "bob").Build(stubMySQL{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`name` LIKE ? ESCAPE '\\\\'"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
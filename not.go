@@ -0,0 +1,41 @@
+package dbr
+
+// NegationBuilder is implemented by conditions that know how to emit
+// their own negated form. Not uses it to push a negation down into the
+// predicate tree structurally instead of wrapping the whole thing in
+// `NOT (...)`.
+type NegationBuilder interface {
+	NegationBuild(d Dialect, buf Buffer) error
+}
+
+// Not wraps cond and builds its negation. When cond implements
+// NegationBuilder the negation is pushed down structurally, e.g.
+// Not(Eq("x", nil)) becomes `x IS NOT NULL` and Not(In("x", vals))
+// becomes `x NOT IN (...)`; And/Or push the negation into their
+// children via De Morgan's law. Builders that don't implement
+// NegationBuilder fall back to `NOT (cond)`.
+func Not(cond Builder) Builder {
+	return &notCond{cond: cond}
+}
+
+type notCond struct {
+	cond Builder
+}
+
+func (n *notCond) Build(d Dialect, buf Buffer) error {
+	if nb, ok := n.cond.(NegationBuilder); ok {
+		return nb.NegationBuild(d, buf)
+	}
+	buf.WriteString("NOT (")
+	if err := n.cond.Build(d, buf); err != nil {
+		return err
+	}
+	buf.WriteString(")")
+	return nil
+}
+
+// NegationBuild makes Not(Not(cond)) collapse back to cond's normal
+// form instead of double-wrapping.
+func (n *notCond) NegationBuild(d Dialect, buf Buffer) error {
+	return n.cond.Build(d, buf)
+}
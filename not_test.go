@@ -0,0 +1,78 @@
+package dbr
+
+import "testing"
+
+func TestNotEqPushesDownToIsNotNull(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(Eq("x", nil)).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`x` IS NOT NULL"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotInPushesDownToNotIn(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(In("x", []int{1, 2})).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`x` NOT IN ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotAndAppliesDeMorgan(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(And(Eq("a", 1), Eq("b", 2))).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "(`a` != ?) OR (`b` != ?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotOrAppliesDeMorgan(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(Or(Eq("a", 1), Eq("b", 2))).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "(`a` != ?) AND (`b` != ?)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotNotCollapses(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(Not(Eq("x", 1))).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`x` = ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+// plainBuilder implements Builder but not NegationBuilder, so Not must
+// fall back to wrapping it in NOT (...).
+type plainBuilder struct{ sql string }
+
+func (p plainBuilder) Build(d Dialect, buf Buffer) error {
+	buf.WriteString(p.sql)
+	return nil
+}
+
+func TestNotFallsBackForUnknownBuilders(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(plainBuilder{sql: "x = 1"}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "NOT (x = 1)"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+package dbr
+
+import (
+	"reflect"
+)
+
+type betweenCond struct {
+	column    string
+	low, high interface{}
+	neg       bool
+}
+
+func (c *betweenCond) build(d Dialect, buf Buffer, neg bool) error {
+	buf.WriteString(d.QuoteIdent(c.column))
+	if neg {
+		buf.WriteString(" NOT BETWEEN ")
+	} else {
+		buf.WriteString(" BETWEEN ")
+	}
+	buf.WriteString(placeholder)
+	buf.WriteValue(c.low)
+	buf.WriteString(" AND ")
+	buf.WriteString(placeholder)
+	buf.WriteValue(c.high)
+	return nil
+}
+
+func (c *betweenCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *betweenCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
+// Between is `BETWEEN low AND high`.
+func Between(column string, low, high interface{}) Builder {
+	return &betweenCond{column: column, low: low, high: high}
+}
+
+// NotBetween is `NOT BETWEEN low AND high`.
+func NotBetween(column string, low, high interface{}) Builder {
+	return &betweenCond{column: column, low: low, high: high, neg: true}
+}
+
+type inCond struct {
+	column string
+	value  interface{}
+	neg    bool
+}
+
+func (c *inCond) build(d Dialect, buf Buffer, neg bool) error {
+	if sub, ok := c.value.(*SelectStmt); ok {
+		buf.WriteString(d.QuoteIdent(c.column))
+		if neg {
+			buf.WriteString(" NOT IN (")
+		} else {
+			buf.WriteString(" IN (")
+		}
+		if err := sub.Build(d, buf); err != nil {
+			return err
+		}
+		buf.WriteString(")")
+		return nil
+	}
+
+	v := reflect.ValueOf(c.value)
+	if v.Kind() != reflect.Slice {
+		return ErrColumnNotSpecified
+	}
+	if v.Len() == 0 {
+		buf.WriteString(d.EncodeBool(neg))
+		return nil
+	}
+	if neg {
+		return buildCmp(d, buf, "NOT IN", c.column, c.value)
+	}
+	return buildCmp(d, buf, "IN", c.column, c.value)
+}
+
+func (c *inCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *inCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
+// In is `IN`. value may be a slice, encoded the same way Eq encodes
+// slices, or a *SelectStmt, which is inlined as a subquery sharing the
+// parent buffer's placeholder stream.
+func In(column string, value interface{}) Builder {
+	return &inCond{column: column, value: value}
+}
+
+// NotIn is `NOT IN`. See In for the accepted value types.
+func NotIn(column string, value interface{}) Builder {
+	return &inCond{column: column, value: value, neg: true}
+}
+
+type existsCond struct {
+	sub *SelectStmt
+	neg bool
+}
+
+func (c *existsCond) build(d Dialect, buf Buffer, neg bool) error {
+	if neg {
+		buf.WriteString("NOT EXISTS (")
+	} else {
+		buf.WriteString("EXISTS (")
+	}
+	if err := c.sub.Build(d, buf); err != nil {
+		return err
+	}
+	buf.WriteString(")")
+	return nil
+}
+
+func (c *existsCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *existsCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
+// Exists is `EXISTS (sub)`.
+func Exists(sub *SelectStmt) Builder {
+	return &existsCond{sub: sub}
+}
+
+// NotExists is `NOT EXISTS (sub)`.
+func NotExists(sub *SelectStmt) Builder {
+	return &existsCond{sub: sub, neg: true}
+}
+
+type isNullCond struct {
+	column string
+	neg    bool
+}
+
+func (c *isNullCond) build(d Dialect, buf Buffer, neg bool) error {
+	buf.WriteString(d.QuoteIdent(c.column))
+	if neg {
+		buf.WriteString(" IS NOT NULL")
+	} else {
+		buf.WriteString(" IS NULL")
+	}
+	return nil
+}
+
+func (c *isNullCond) Build(d Dialect, buf Buffer) error         { return c.build(d, buf, c.neg) }
+func (c *isNullCond) NegationBuild(d Dialect, buf Buffer) error { return c.build(d, buf, !c.neg) }
+
+// IsNull is `IS NULL`. It reads better than Eq(column, nil) when there
+// is no comparison value involved.
+func IsNull(column string) Builder {
+	return &isNullCond{column: column}
+}
+
+// IsNotNull is `IS NOT NULL`.
+func IsNotNull(column string) Builder {
+	return &isNullCond{column: column, neg: true}
+}
@@ -0,0 +1,95 @@
+package dbr
+
+import "testing"
+
+// Exists/NotExists and the *SelectStmt branch of In/NotIn depend on
+// SelectStmt, which lives in select.go outside this chunk of the repo;
+// they're exercised alongside the statement builders instead.
+
+func TestBetween(t *testing.T) {
+	buf := newStubBuffer()
+	err := Between("age", 18, 65).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`age` BETWEEN ? AND ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+	if got, want := buf.Value(), []interface{}{18, 65}; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+}
+
+func TestNotBetweenNegation(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(Between("age", 18, 65)).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`age` NOT BETWEEN ? AND ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestInSlice(t *testing.T) {
+	buf := newStubBuffer()
+	err := In("id", []int{1, 2, 3}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`id` IN ?"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestInEmptySliceIsAlwaysFalse(t *testing.T) {
+	buf := newStubBuffer()
+	err := In("id", []int{}).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "0"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestInRejectsNonSlice(t *testing.T) {
+	buf := newStubBuffer()
+	err := In("id", 1).Build(stubDialect{}, buf)
+	if err != ErrColumnNotSpecified {
+		t.Errorf("err = %v, want ErrColumnNotSpecified", err)
+	}
+}
+
+func TestNotInEmptySliceIsAlwaysTrue(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(In("id", []int{})).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "1"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestIsNull(t *testing.T) {
+	buf := newStubBuffer()
+	err := IsNull("deleted_at").Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`deleted_at` IS NULL"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
+
+func TestNotIsNullBecomesIsNotNull(t *testing.T) {
+	buf := newStubBuffer()
+	err := Not(IsNull("deleted_at")).Build(stubDialect{}, buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "`deleted_at` IS NOT NULL"; got != want {
+		t.Errorf("sql = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package dbr
+
+import "strings"
+
+// stubDialect is a minimal Dialect double used by this package's unit
+// tests. It deliberately does not implement CaseInsensitiveLikeDialect
+// or LikeEscapeClauseDialect so tests can exercise the portable
+// fallback paths; stubPostgres and stubMySQL below cover the optional
+// hooks.
+type stubDialect struct{}
+
+func (stubDialect) QuoteIdent(s string) string { return "`" + s + "`" }
+func (stubDialect) EncodeBool(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// stubPostgres implements CaseInsensitiveLikeDialect the way a real
+// PostgreSQL dialect would, emitting ILIKE directly.
+type stubPostgres struct{ stubDialect }
+
+func (stubPostgres) CaseInsensitiveLike(column, operator, ph string) string {
+	return column + " " + operator + " " + ph
+}
+
+// stubMySQL implements LikeEscapeClauseDialect the way a real MySQL
+// dialect would, doubling the backslash so the string literal
+// terminates under the default sql_mode.
+type stubMySQL struct{ stubDialect }
+
+func (stubMySQL) LikeEscapeClause() string { return ` ESCAPE '\\'` }
+
+// stubBuffer is a minimal Buffer double that records the built SQL text
+// and bound values for assertions.
+type stubBuffer struct {
+	sb   strings.Builder
+	vals []interface{}
+}
+
+func newStubBuffer() *stubBuffer { return &stubBuffer{} }
+
+func (b *stubBuffer) WriteString(s string) (int, error) { return b.sb.WriteString(s) }
+func (b *stubBuffer) WriteValue(v interface{}) bool {
+	b.vals = append(b.vals, v)
+	return true
+}
+func (b *stubBuffer) String() string       { return b.sb.String() }
+func (b *stubBuffer) Value() []interface{} { return b.vals }